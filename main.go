@@ -1,200 +1,76 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
-	"html/template"
-	"io/ioutil"
 	"net/http"
-	"net/http/httputil"
 	"os"
-	"strings"
-	"time"
-
-	yaml "gopkg.in/yaml.v2"
 
 	"github.com/pkg/errors"
-)
 
-var dryRun = false
+	"github.com/cumet04/sbox_qiita_api/qiita"
+	"github.com/cumet04/sbox_qiita_api/sync"
+)
 
-// Item represents the Qiita API's model:item
-type Item struct {
-	ID           string    `json:"id"`
-	Title        string    `json:"title"`
-	Body         string    `json:"body"`
-	RenderedBody string    `json:"rendered_body"`
-	Private      bool      `json:"private"`
-	Tags         []Tagging `json:"tags"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-}
+// postsDir is where `pull`/`push`/`status` keep their mirror of the
+// authenticated user's items.
+const postsDir = "_posts"
 
-// Tagging represents the Qiita API's model:tagging
-type Tagging struct {
-	Name     string   `json:"name"`
-	Versions []string `json:"versions"`
-}
+var (
+	recordDir = flag.String("record", "", "write a cassette of every HTTP request/response to this directory")
+	replayDir = flag.String("replay", "", "serve HTTP requests from cassettes in this directory instead of calling Qiita")
+)
 
 func main() {
-	buf, err := ioutil.ReadFile("_posts/sample.md")
-	if err != nil {
-		panic(err)
+	flag.Parse()
+	args := flag.Args()
+	if len(args) < 1 {
+		fatal(errors.New("usage: sbox_qiita_api [-record dir | -replay dir] <pull|push|status> [path]"))
 	}
-	it := ParseMarkdown(string(buf))
-	r, err := PostNewItem(*it)
-	if err != nil {
-		panic(err)
-	}
-	st, err := r.ToMarkdown()
-	if err != nil {
-		panic(err)
-	}
-	println(st)
-}
 
-func (t Tagging) String() string {
-	res := t.Name
-	if len(t.Versions) > 0 {
-		res = res + ":" + strings.Join(t.Versions, ",")
-	}
-	return res
-}
+	client := qiita.NewClient(os.Getenv("QIITA_API_TOKEN"), clientOptions()...)
+	ctx := context.Background()
 
-// MarshalJSON is same as normal MarshalJSON except to emit null tags
-func (t Tagging) MarshalJSON() ([]byte, error) {
-	s := fmt.Sprintf(`{"name":"%s"`, t.Name)
-	if t.Versions != nil {
-		vs, err := json.Marshal(t.Versions)
+	switch args[0] {
+	case "pull":
+		if err := sync.Pull(ctx, client, postsDir); err != nil {
+			fatal(err)
+		}
+	case "push":
+		if len(args) < 2 {
+			fatal(errors.New("usage: sbox_qiita_api push <path>"))
+		}
+		item, err := sync.Push(ctx, client, postsDir, args[1])
 		if err != nil {
-			return nil, err
+			fatal(err)
 		}
-		s = fmt.Sprintf(`%s, "tags":%s`, s, vs)
-	}
-	s = s + "}"
-	return []byte(s), nil
-}
-
-// ToMarkdown converts Item to a markdown string
-func (item *Item) ToMarkdown() (string, error) {
-	var tagStrs []string
-	for _, t := range item.Tags {
-		tagStrs = append(tagStrs, t.String())
-	}
-	const templateText = `---
-title: {{ .Title }}
-tags:{{ range .Tags }} {{ . -}} {{ end }}
-private: {{ .Private }}
----
-{{ .Body }}`
-	tpl, err := template.New("markdown").Parse(templateText)
-	if err != nil {
-		return "", err
-	}
-	var writer bytes.Buffer
-	err = tpl.Execute(&writer, item)
-	if err != nil {
-		return "", err
-	}
-	return writer.String(), nil
-}
-
-// ParseMarkdown reads Qiita's Markdown text and generates Item from that
-func ParseMarkdown(src string) *Item {
-	var res Item
-	res.Private = true
-	sections := strings.SplitN(src, "---\n", 3)
-
-	var meta map[string]interface{}
-	yaml.Unmarshal([]byte(sections[1]), &meta)
-
-	res.Body = sections[2]
-	res.Title = meta["title"].(string)
-	if priv, ok := meta["private"]; ok {
-		res.Private = priv.(bool)
-	}
-	if tagv, ok := meta["tags"]; ok && tagv != nil {
-		for _, t := range strings.Split(meta["tags"].(string), " ") {
-			res.Tags = append(res.Tags, *ParseTagging(t))
+		fmt.Printf("pushed %s -> %s\n", args[1], item.URL)
+	case "status":
+		changes, err := sync.Status(postsDir)
+		if err != nil {
+			fatal(err)
 		}
+		for _, c := range changes {
+			fmt.Printf("%s\t%s\n", c.Status, c.Path)
+		}
+	default:
+		fatal(errors.Errorf("unknown command %q", args[0]))
 	}
-
-	return &res
-}
-
-// ParseTagging generates Tagging from serialized string
-func ParseTagging(src string) *Tagging {
-	var res Tagging
-	seps := strings.SplitN(src, ":", 2)
-	res.Name = seps[0]
-	if len(seps) == 2 {
-		res.Versions = strings.Split(seps[1], ",")
-	}
-	return &res
-}
-
-func PostNewItem(item Item) (*Item, error) {
-	postdata := map[string]interface{}{
-		"body":    item.Body,
-		"tags":    item.Tags,
-		"title":   item.Title,
-		"private": item.Private,
-	}
-	postbytes, err := json.Marshal(postdata)
-	if err != nil {
-		return nil, err
-	}
-	req, _ := http.NewRequest("POST", "https://qiita.com/api/v2/items", bytes.NewBuffer(postbytes))
-	req.Header.Set("Content-Type", "application/json")
-
-	resbytes, err := DoRequest(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to DoRequest")
-	}
-	var res Item
-	if err := json.Unmarshal(resbytes, &res); err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal")
-	}
-	return &res, nil
 }
 
-// GetSelfItems fetch Items using Qiita API: authenticated_user/items
-func GetSelfItems() ([]Item, error) {
-	req, _ := http.NewRequest("GET", "https://qiita.com/api/v2/authenticated_user/items", nil)
-	resbyte, err := DoRequest(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to DoRequest")
+func clientOptions() []qiita.Option {
+	var opts []qiita.Option
+	if *replayDir != "" {
+		opts = append(opts, qiita.WithHTTPClient(&http.Client{Transport: &qiita.ReplayTransport{Dir: *replayDir}}))
 	}
-
-	var items []Item
-	if err := json.Unmarshal(resbyte, &items); err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal")
+	if *recordDir != "" {
+		opts = append(opts, qiita.WithRecorder(&qiita.Recorder{Dir: *recordDir}))
 	}
-	return items, nil
+	return opts
 }
 
-// DoRequest sends the request and return json response as interface{}
-func DoRequest(req *http.Request) ([]byte, error) {
-	req.Header.Set("Authorization", "Bearer "+os.Getenv("QIITA_API_TOKEN"))
-	if dryRun {
-		a, _ := httputil.DumpRequest(req, true)
-		fmt.Println(string(a))
-		return []byte{}, nil
-	}
-
-	client := new(http.Client)
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to do request")
-	}
-	defer resp.Body.Close()
-
-	// TODO: handling error status code
-
-	res, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to read response body")
-	}
-	return res, nil
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
 }
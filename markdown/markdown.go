@@ -0,0 +1,111 @@
+// Package markdown converts between Qiita items and the Markdown-with-YAML
+// front-matter representation used to keep a local copy of a post on disk.
+package markdown
+
+import (
+	"bytes"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/pkg/errors"
+
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+const delimiter = "---\n"
+
+// Encode renders an Item as Markdown with a YAML front-matter header,
+// preserving id/created_at/updated_at/url so the file can be decoded back
+// into an equivalent Item.
+func Encode(item *qiita.Item) ([]byte, error) {
+	var fm yaml.MapSlice
+	if item.ID != "" {
+		fm = append(fm, yaml.MapItem{Key: "id", Value: item.ID})
+	}
+	fm = append(fm, yaml.MapItem{Key: "title", Value: item.Title})
+	if len(item.Tags) > 0 {
+		fm = append(fm, yaml.MapItem{Key: "tags", Value: encodeTags(item.Tags)})
+	}
+	fm = append(fm, yaml.MapItem{Key: "private", Value: item.Private})
+	if !item.CreatedAt.IsZero() {
+		fm = append(fm, yaml.MapItem{Key: "created_at", Value: item.CreatedAt.Format(time.RFC3339)})
+	}
+	if !item.UpdatedAt.IsZero() {
+		fm = append(fm, yaml.MapItem{Key: "updated_at", Value: item.UpdatedAt.Format(time.RFC3339)})
+	}
+	if item.URL != "" {
+		fm = append(fm, yaml.MapItem{Key: "url", Value: item.URL})
+	}
+
+	fmBytes, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal front matter")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(delimiter)
+	buf.Write(fmBytes)
+	buf.WriteString(delimiter)
+	buf.WriteString(item.Body)
+	return buf.Bytes(), nil
+}
+
+// Decode parses Markdown with a YAML front-matter header into an Item.
+// The tags entry may be the space-separated shorthand ("ruby:2.7,3.0 go")
+// or a YAML sequence of {name, versions} maps.
+func Decode(src []byte) (*qiita.Item, error) {
+	src = bytes.ReplaceAll(src, []byte("\r\n"), []byte("\n"))
+	parts := bytes.SplitN(src, []byte(delimiter), 3)
+	if len(parts) < 3 {
+		return nil, errors.New("markdown: missing front matter")
+	}
+
+	var fm yaml.MapSlice
+	if err := yaml.Unmarshal(parts[1], &fm); err != nil {
+		return nil, errors.Wrap(err, "failed to parse front matter")
+	}
+
+	item := &qiita.Item{Private: true, Body: string(parts[2])}
+	for _, kv := range fm {
+		key, _ := kv.Key.(string)
+		switch key {
+		case "id":
+			item.ID, _ = kv.Value.(string)
+		case "title":
+			item.Title, _ = kv.Value.(string)
+		case "private":
+			if b, ok := kv.Value.(bool); ok {
+				item.Private = b
+			}
+		case "url":
+			item.URL, _ = kv.Value.(string)
+		case "created_at":
+			item.CreatedAt = decodeTime(kv.Value)
+		case "updated_at":
+			item.UpdatedAt = decodeTime(kv.Value)
+		case "tags":
+			tags, err := decodeTags(kv.Value)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse tags")
+			}
+			item.Tags = tags
+		}
+	}
+	return item, nil
+}
+
+func decodeTime(v interface{}) time.Time {
+	switch t := v.(type) {
+	case time.Time:
+		return t
+	case string:
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return time.Time{}
+		}
+		return parsed
+	default:
+		return time.Time{}
+	}
+}
@@ -0,0 +1,146 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+func TestEncode_OmitsEmptyTags(t *testing.T) {
+	encoded, err := Encode(&qiita.Item{Title: "t", Body: "body"})
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	if strings.Contains(string(encoded), "tags:") {
+		t.Errorf("expected no tags key in output, got:\n%s", encoded)
+	}
+}
+
+func TestDecode_Tags(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want []qiita.Tagging
+	}{
+		{
+			name: "shorthand with versions",
+			src:  "---\ntitle: t\ntags: ruby:2.7,3.0 go\nprivate: false\n---\nbody",
+			want: []qiita.Tagging{
+				{Name: "ruby", Versions: []string{"2.7", "3.0"}},
+				{Name: "go"},
+			},
+		},
+		{
+			name: "tags null",
+			src:  "---\ntitle: t\ntags: null\nprivate: false\n---\nbody",
+			want: nil,
+		},
+		{
+			name: "tags omitted",
+			src:  "---\ntitle: t\nprivate: false\n---\nbody",
+			want: nil,
+		},
+		{
+			name: "sequence of maps",
+			src:  "---\ntitle: t\ntags:\n  - name: ruby\n    versions: [\"2.7\", \"3.0\"]\n  - name: go\nprivate: false\n---\nbody",
+			want: []qiita.Tagging{
+				{Name: "ruby", Versions: []string{"2.7", "3.0"}},
+				{Name: "go"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item, err := Decode([]byte(tc.src))
+			if err != nil {
+				t.Fatalf("Decode returned error: %v", err)
+			}
+			if len(item.Tags) != len(tc.want) {
+				t.Fatalf("got %d tags, want %d: %+v", len(item.Tags), len(tc.want), item.Tags)
+			}
+			for i, tag := range item.Tags {
+				if tag.Name != tc.want[i].Name {
+					t.Errorf("tag[%d].Name = %q, want %q", i, tag.Name, tc.want[i].Name)
+				}
+				if len(tag.Versions) != len(tc.want[i].Versions) {
+					t.Errorf("tag[%d].Versions = %v, want %v", i, tag.Versions, tc.want[i].Versions)
+				}
+			}
+		})
+	}
+}
+
+func TestDecode_MissingFrontMatter(t *testing.T) {
+	if _, err := Decode([]byte("no front matter here")); err == nil {
+		t.Fatal("expected an error for missing front matter")
+	}
+}
+
+func TestDecode_CRLF(t *testing.T) {
+	src := "---\r\ntitle: t\r\ntags: go\r\nprivate: false\r\n---\r\nbody line\r\n"
+	item, err := Decode([]byte(src))
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if item.Title != "t" {
+		t.Errorf("Title = %q, want %q", item.Title, "t")
+	}
+	if item.Body != "body line\n" {
+		t.Errorf("Body = %q, want %q", item.Body, "body line\n")
+	}
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	created := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	original := &qiita.Item{
+		ID:      "abc123",
+		Title:   "hello",
+		Body:    "# heading\nbody text\n",
+		Private: true,
+		Tags: []qiita.Tagging{
+			{Name: "ruby", Versions: []string{"2.7", "3.0"}},
+			{Name: "go"},
+		},
+		URL:       "https://qiita.com/cumet04/items/abc123",
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+
+	encoded, err := Encode(original)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+
+	if decoded.ID != original.ID {
+		t.Errorf("ID = %q, want %q", decoded.ID, original.ID)
+	}
+	if decoded.Title != original.Title {
+		t.Errorf("Title = %q, want %q", decoded.Title, original.Title)
+	}
+	if decoded.Body != original.Body {
+		t.Errorf("Body = %q, want %q", decoded.Body, original.Body)
+	}
+	if decoded.Private != original.Private {
+		t.Errorf("Private = %v, want %v", decoded.Private, original.Private)
+	}
+	if decoded.URL != original.URL {
+		t.Errorf("URL = %q, want %q", decoded.URL, original.URL)
+	}
+	if !decoded.CreatedAt.Equal(original.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", decoded.CreatedAt, original.CreatedAt)
+	}
+	if !decoded.UpdatedAt.Equal(original.UpdatedAt) {
+		t.Errorf("UpdatedAt = %v, want %v", decoded.UpdatedAt, original.UpdatedAt)
+	}
+	if len(decoded.Tags) != len(original.Tags) {
+		t.Fatalf("got %d tags, want %d", len(decoded.Tags), len(original.Tags))
+	}
+}
@@ -0,0 +1,86 @@
+package markdown
+
+import (
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/pkg/errors"
+
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+// encodeTags renders tags as the space-separated shorthand, e.g.
+// "ruby:2.7,3.0 go", matching how qiita.Tagging.String formats a single tag.
+func encodeTags(tags []qiita.Tagging) string {
+	strs := make([]string, len(tags))
+	for i, t := range tags {
+		strs[i] = t.String()
+	}
+	return strings.Join(strs, " ")
+}
+
+// decodeTags accepts either the space-separated shorthand or a YAML
+// sequence of {name, versions} maps.
+func decodeTags(v interface{}) ([]qiita.Tagging, error) {
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if strings.TrimSpace(t) == "" {
+			return nil, nil
+		}
+		var tags []qiita.Tagging
+		for _, s := range strings.Fields(t) {
+			tags = append(tags, decodeTagShorthand(s))
+		}
+		return tags, nil
+	case []interface{}:
+		var tags []qiita.Tagging
+		for _, entry := range t {
+			tag, err := decodeTagEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			tags = append(tags, tag)
+		}
+		return tags, nil
+	default:
+		return nil, errors.Errorf("markdown: unsupported tags value %T", v)
+	}
+}
+
+func decodeTagShorthand(s string) qiita.Tagging {
+	name, versions, ok := strings.Cut(s, ":")
+	tag := qiita.Tagging{Name: name}
+	if ok && versions != "" {
+		tag.Versions = strings.Split(versions, ",")
+	}
+	return tag
+}
+
+func decodeTagEntry(v interface{}) (qiita.Tagging, error) {
+	switch e := v.(type) {
+	case string:
+		return decodeTagShorthand(e), nil
+	case yaml.MapSlice:
+		var tag qiita.Tagging
+		for _, kv := range e {
+			key, _ := kv.Key.(string)
+			switch key {
+			case "name":
+				tag.Name, _ = kv.Value.(string)
+			case "versions":
+				seq, _ := kv.Value.([]interface{})
+				for _, v := range seq {
+					if s, ok := v.(string); ok {
+						tag.Versions = append(tag.Versions, s)
+					}
+				}
+			}
+		}
+		return tag, nil
+	default:
+		return qiita.Tagging{}, errors.Errorf("markdown: unsupported tag entry %T", v)
+	}
+}
@@ -0,0 +1,93 @@
+// Package qiita provides a typed client for the Qiita API v2
+// (https://qiita.com/api/v2/docs).
+package qiita
+
+import "net/http"
+
+// BaseURLFunc resolves the full request URL for a given sub-domain (empty
+// for qiita.com itself, or a Qiita Team name) and API path.
+type BaseURLFunc func(subDomain, path string) string
+
+func defaultBaseURL(subDomain, path string) string {
+	host := "qiita.com"
+	if subDomain != "" {
+		host = subDomain + ".qiita.com"
+	}
+	return "https://" + host + path
+}
+
+// Client is a Qiita API v2 client.
+type Client struct {
+	token       string
+	subDomain   string
+	httpClient  *http.Client
+	baseURL     BaseURLFunc
+	retryPolicy RetryPolicy
+	rateLimit   RateLimit
+}
+
+// Option configures a Client constructed via NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the *http.Client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithSubDomain targets a Qiita Team sub-domain (e.g. "example" for
+// https://example.qiita.com) instead of qiita.com itself.
+func WithSubDomain(subDomain string) Option {
+	return func(c *Client) { c.subDomain = subDomain }
+}
+
+// WithBaseURL overrides how request URLs are resolved, e.g. to point at an
+// httptest.NewServer in tests.
+func WithBaseURL(fn BaseURLFunc) Option {
+	return func(c *Client) { c.baseURL = fn }
+}
+
+// WithRetryPolicy overrides the retry behavior used for 429/5xx responses.
+func WithRetryPolicy(rp RetryPolicy) Option {
+	return func(c *Client) { c.retryPolicy = rp }
+}
+
+// WithRecorder has the Client feed every request/response pair it sends to
+// r as it happens, so a real session can be captured once and replayed
+// offline later via ReplayTransport. Apply after WithHTTPClient if both are
+// used, since this wraps whatever transport the client already has.
+func WithRecorder(r *Recorder) Option {
+	return func(c *Client) {
+		next := c.httpClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		hc := *c.httpClient
+		hc.Transport = &recordingTransport{next: next, recorder: r}
+		c.httpClient = &hc
+	}
+}
+
+// NewClient creates a Client that authenticates with the given personal
+// access token.
+func NewClient(token string, opts ...Option) *Client {
+	c := &Client{
+		token:       token,
+		httpClient:  http.DefaultClient,
+		baseURL:     defaultBaseURL,
+		retryPolicy: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// RateLimit returns the rate-limit state observed on the most recent
+// response.
+func (c *Client) RateLimit() RateLimit {
+	return c.rateLimit
+}
+
+func (c *Client) url(path string) string {
+	return c.baseURL(c.subDomain, path)
+}
@@ -0,0 +1,16 @@
+package qiita
+
+import "fmt"
+
+// StatusError is returned whenever the Qiita API responds with a status
+// code of 400 or above, so callers can distinguish e.g. 401 from 404.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+	URL        string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("qiita: %s: %s", e.URL, e.Status)
+}
@@ -0,0 +1,119 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Item represents the Qiita API's model:item.
+type Item struct {
+	ID           string    `json:"id"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	RenderedBody string    `json:"rendered_body"`
+	Private      bool      `json:"private"`
+	Tags         []Tagging `json:"tags"`
+	URL          string    `json:"url"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Tagging represents the Qiita API's model:tagging.
+type Tagging struct {
+	Name     string   `json:"name"`
+	Versions []string `json:"versions"`
+}
+
+// ListItemsParameters configures ListItems and ListAuthenticatedUserItems.
+type ListItemsParameters struct {
+	PageParameters
+	// Query is a Qiita search query, e.g. "tag:Go user:cumet04".
+	Query string
+}
+
+func (p ListItemsParameters) values() url.Values {
+	v := p.PageParameters.values()
+	if p.Query != "" {
+		v.Set("query", p.Query)
+	}
+	return v
+}
+
+// ListItems fetches public items, most recently created first.
+func (c *Client) ListItems(ctx context.Context, params ListItemsParameters) ([]Item, Link, error) {
+	var items []Item
+	link, err := c.doRequestPaged(ctx, http.MethodGet, "/api/v2/items", params.values(), &items)
+	return items, link, err
+}
+
+// ListAuthenticatedUserItems fetches items belonging to the authenticated
+// user, including private ones.
+func (c *Client) ListAuthenticatedUserItems(ctx context.Context, params ListItemsParameters) ([]Item, Link, error) {
+	var items []Item
+	link, err := c.doRequestPaged(ctx, http.MethodGet, "/api/v2/authenticated_user/items", params.values(), &items)
+	return items, link, err
+}
+
+// GetItem fetches a single item by ID.
+func (c *Client) GetItem(ctx context.Context, id string) (*Item, error) {
+	var item Item
+	path := fmt.Sprintf("/api/v2/items/%s", id)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, nil, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// CreateItemParameters is the request body for CreateItem.
+type CreateItemParameters struct {
+	Title   string    `json:"title"`
+	Body    string    `json:"body"`
+	Tags    []Tagging `json:"tags"`
+	Private bool      `json:"private"`
+}
+
+// CreateItem posts a new item as the authenticated user.
+func (c *Client) CreateItem(ctx context.Context, params CreateItemParameters) (*Item, error) {
+	var item Item
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v2/items", nil, params, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// UpdateItemParameters is the request body for UpdateItem. Zero-value
+// fields are sent as-is; Qiita's PATCH endpoint is not a partial update.
+type UpdateItemParameters struct {
+	Title   string    `json:"title"`
+	Body    string    `json:"body"`
+	Tags    []Tagging `json:"tags"`
+	Private bool      `json:"private"`
+}
+
+// UpdateItem overwrites an existing item owned by the authenticated user.
+func (c *Client) UpdateItem(ctx context.Context, id string, params UpdateItemParameters) (*Item, error) {
+	var item Item
+	path := fmt.Sprintf("/api/v2/items/%s", id)
+	if err := c.doRequest(ctx, http.MethodPatch, path, nil, params, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// DeleteItem deletes an item owned by the authenticated user.
+func (c *Client) DeleteItem(ctx context.Context, id string) error {
+	path := fmt.Sprintf("/api/v2/items/%s", id)
+	return c.doRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+func (t Tagging) String() string {
+	res := t.Name
+	if len(t.Versions) > 0 {
+		res = res + ":" + strings.Join(t.Versions, ",")
+	}
+	return res
+}
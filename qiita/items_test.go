@@ -0,0 +1,84 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return NewClient("token", WithBaseURL(func(subDomain, path string) string { return srv.URL + path }))
+}
+
+func TestListItems_ParsesLinkHeader(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/items" {
+			t.Errorf("path = %q, want /api/v2/items", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("page"); got != "2" {
+			t.Errorf("page query = %q, want %q", got, "2")
+		}
+		w.Header().Set("Link", `<https://qiita.com/api/v2/items?page=3>; rel="next", <https://qiita.com/api/v2/items?page=5>; rel="last"`)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"1","title":"one"},{"id":"2","title":"two"}]`)
+	})
+
+	items, link, err := client.ListItems(context.Background(), ListItemsParameters{PageParameters: PageParameters{Page: 2}})
+	if err != nil {
+		t.Fatalf("ListItems returned error: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != "1" || items[1].ID != "2" {
+		t.Errorf("items = %+v, want ids 1 and 2", items)
+	}
+	if link.Next != "https://qiita.com/api/v2/items?page=3" {
+		t.Errorf("link.Next = %q", link.Next)
+	}
+	if link.Last != "https://qiita.com/api/v2/items?page=5" {
+		t.Errorf("link.Last = %q", link.Last)
+	}
+}
+
+func TestListAuthenticatedUserItems_ParsesLinkHeader(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/authenticated_user/items" {
+			t.Errorf("path = %q, want /api/v2/authenticated_user/items", r.URL.Path)
+		}
+		w.Header().Set("Link", `<https://qiita.com/api/v2/authenticated_user/items?page=2>; rel="next"`)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"1","title":"one","private":true}]`)
+	})
+
+	items, link, err := client.ListAuthenticatedUserItems(context.Background(), ListItemsParameters{})
+	if err != nil {
+		t.Fatalf("ListAuthenticatedUserItems returned error: %v", err)
+	}
+	if len(items) != 1 || !items[0].Private {
+		t.Errorf("items = %+v, want one private item", items)
+	}
+	if link.Next == "" {
+		t.Error("link.Next is empty, want a next page URL")
+	}
+}
+
+func TestDeleteItem(t *testing.T) {
+	var gotMethod, gotPath string
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteItem(context.Background(), "1"); err != nil {
+		t.Fatalf("DeleteItem returned error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("method = %q, want DELETE", gotMethod)
+	}
+	if gotPath != "/api/v2/items/1" {
+		t.Errorf("path = %q, want /api/v2/items/1", gotPath)
+	}
+}
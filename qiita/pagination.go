@@ -0,0 +1,34 @@
+package qiita
+
+import (
+	"net/http"
+	"regexp"
+)
+
+// Link holds the pagination URLs parsed out of a response's Link header.
+type Link struct {
+	First string
+	Prev  string
+	Next  string
+	Last  string
+}
+
+var linkEntryRe = regexp.MustCompile(`<([^>]+)>;\s*rel="(\w+)"`)
+
+func parseLink(h http.Header) Link {
+	var link Link
+	for _, m := range linkEntryRe.FindAllStringSubmatch(h.Get("Link"), -1) {
+		url, rel := m[1], m[2]
+		switch rel {
+		case "first":
+			link.First = url
+		case "prev":
+			link.Prev = url
+		case "next":
+			link.Next = url
+		case "last":
+			link.Last = url
+		}
+	}
+	return link
+}
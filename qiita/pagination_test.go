@@ -0,0 +1,51 @@
+package qiita
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseLink(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		want   Link
+	}{
+		{
+			name:   "all four rels",
+			header: `<https://qiita.com/api/v2/items?page=1>; rel="first", <https://qiita.com/api/v2/items?page=1>; rel="prev", <https://qiita.com/api/v2/items?page=3>; rel="next", <https://qiita.com/api/v2/items?page=10>; rel="last"`,
+			want: Link{
+				First: "https://qiita.com/api/v2/items?page=1",
+				Prev:  "https://qiita.com/api/v2/items?page=1",
+				Next:  "https://qiita.com/api/v2/items?page=3",
+				Last:  "https://qiita.com/api/v2/items?page=10",
+			},
+		},
+		{
+			name:   "only next and last, as on the first page",
+			header: `<https://qiita.com/api/v2/items?page=2>; rel="next", <https://qiita.com/api/v2/items?page=10>; rel="last"`,
+			want: Link{
+				Next: "https://qiita.com/api/v2/items?page=2",
+				Last: "https://qiita.com/api/v2/items?page=10",
+			},
+		},
+		{
+			name:   "missing header, as on the last page",
+			header: "",
+			want:   Link{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := http.Header{}
+			if tc.header != "" {
+				h.Set("Link", tc.header)
+			}
+			got := parseLink(h)
+			if got != tc.want {
+				t.Errorf("parseLink() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package qiita
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// PageParameters is embedded by list parameter structs that support
+// page-based pagination.
+type PageParameters struct {
+	// Page is the 1-indexed page number. Zero means unset (API default).
+	Page int
+	// PerPage is the number of items per page (API default 20, max 100).
+	PerPage int
+}
+
+func (p PageParameters) values() url.Values {
+	v := url.Values{}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	return v
+}
@@ -0,0 +1,27 @@
+package qiita
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit reflects the Rate-Limit-* headers returned on the most recent
+// response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func parseRateLimit(h http.Header) RateLimit {
+	limit, _ := strconv.Atoi(h.Get("Rate-Limit-Limit"))
+	remaining, _ := strconv.Atoi(h.Get("Rate-Limit-Remaining"))
+	reset, _ := strconv.ParseInt(h.Get("Rate-Limit-Reset"), 10, 64)
+
+	rl := RateLimit{Limit: limit, Remaining: remaining}
+	if reset > 0 {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	return rl
+}
@@ -0,0 +1,90 @@
+package qiita
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const responseSeparator = "\n<<< RESPONSE\n"
+
+// Recorder captures each request/response pair sent through a Client, for
+// replay via ReplayTransport. Set exactly one of Writer or Dir: Writer
+// appends every pair as it happens, Dir writes one cassette file per
+// request keyed by method+path+body-hash (so retries of the same request
+// overwrite rather than accumulate).
+type Recorder struct {
+	Writer io.Writer
+	Dir    string
+}
+
+func (r *Recorder) record(req *http.Request, reqDump, respDump []byte) error {
+	cassette := append(append([]byte(">>> REQUEST\n"), reqDump...), []byte(responseSeparator)...)
+	cassette = append(cassette, respDump...)
+
+	if r.Writer != nil {
+		if _, err := r.Writer.Write(append(cassette, '\n')); err != nil {
+			return errors.Wrap(err, "failed to write recorded request")
+		}
+	}
+	if r.Dir != "" {
+		if err := os.MkdirAll(r.Dir, 0755); err != nil {
+			return errors.Wrap(err, "failed to create cassette directory")
+		}
+		path := filepath.Join(r.Dir, cassetteKey(req)+".http")
+		if err := os.WriteFile(path, cassette, 0644); err != nil {
+			return errors.Wrapf(err, "failed to write cassette %s", path)
+		}
+	}
+	return nil
+}
+
+// cassetteKey identifies a request by method, path and a hash of its body,
+// so the same logical request always maps to the same cassette file name.
+func cassetteKey(req *http.Request) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", req.Method, req.URL.Path)
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			io.Copy(h, body)
+			body.Close()
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// recordingTransport wraps an http.RoundTripper, feeding every
+// request/response pair it sees to a Recorder.
+type recordingTransport struct {
+	next     http.RoundTripper
+	recorder *Recorder
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqDump, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dump request")
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respDump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dump response")
+	}
+
+	if err := t.recorder.record(req, reqDump, respDump); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
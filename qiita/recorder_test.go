@@ -0,0 +1,45 @@
+package qiita
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"42","title":"recorded"}`))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	recording := NewClient("token",
+		WithBaseURL(func(subDomain, path string) string { return srv.URL + path }),
+		WithRecorder(&Recorder{Dir: dir}),
+	)
+	if _, err := recording.GetItem(context.Background(), "42"); err != nil {
+		t.Fatalf("GetItem (recording) returned error: %v", err)
+	}
+
+	entries, err := filepath.Glob(filepath.Join(dir, "*.http"))
+	if err != nil {
+		t.Fatalf("Glob returned error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d cassette files, want 1: %v", len(entries), entries)
+	}
+
+	replaying := NewClient("unused",
+		WithHTTPClient(&http.Client{Transport: &ReplayTransport{Dir: dir}}),
+	)
+	item, err := replaying.GetItem(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("GetItem (replaying) returned error: %v", err)
+	}
+	if item.Title != "recorded" {
+		t.Errorf("Title = %q, want %q", item.Title, "recorded")
+	}
+}
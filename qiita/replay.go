@@ -0,0 +1,39 @@
+package qiita
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// ReplayTransport is an http.RoundTripper that serves responses from
+// cassette files written by a directory-mode Recorder, so a recorded
+// session can be replayed offline.
+type ReplayTransport struct {
+	Dir string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := filepath.Join(t.Dir, cassetteKey(req)+".http")
+	cassette, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "replay: no cassette for %s %s", req.Method, req.URL.Path)
+	}
+
+	idx := bytes.Index(cassette, []byte(responseSeparator))
+	if idx < 0 {
+		return nil, errors.Errorf("replay: malformed cassette %s", path)
+	}
+	respDump := cassette[idx+len(responseSeparator):]
+
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(respDump)), req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "replay: failed to parse cassette %s", path)
+	}
+	return resp, nil
+}
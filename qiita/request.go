@@ -0,0 +1,106 @@
+package qiita
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// doRequest sends an authenticated request and unmarshals a successful JSON
+// response into out (when out is non-nil). It returns a *StatusError when
+// the response status is >= 400.
+func (c *Client) doRequest(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	u := c.url(path)
+	if len(query) > 0 {
+		u = u + "?" + query.Encode()
+	}
+
+	var bodyBytes []byte
+	header := http.Header{}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body")
+		}
+		bodyBytes = b
+		header.Set("Content-Type", "application/json")
+	}
+
+	status, respHeader, respBody, err := c.sendWithRetry(ctx, method, u, header, bodyBytes)
+	if err != nil {
+		return err
+	}
+
+	c.rateLimit = parseRateLimit(respHeader)
+
+	if status >= 400 {
+		return &StatusError{StatusCode: status, Status: http.StatusText(status), Body: respBody, URL: u}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return errors.Wrap(err, "failed to unmarshal response body")
+		}
+	}
+	return nil
+}
+
+// doRequestPaged behaves like doRequest but also returns the Link header
+// parsed for cursor-style pagination, for endpoints that return a list.
+func (c *Client) doRequestPaged(ctx context.Context, method, path string, query url.Values, out interface{}) (Link, error) {
+	u := c.url(path)
+	if len(query) > 0 {
+		u = u + "?" + query.Encode()
+	}
+
+	status, respHeader, respBody, err := c.sendWithRetry(ctx, method, u, nil, nil)
+	if err != nil {
+		return Link{}, err
+	}
+
+	c.rateLimit = parseRateLimit(respHeader)
+	link := parseLink(respHeader)
+
+	if status >= 400 {
+		return link, &StatusError{StatusCode: status, Status: http.StatusText(status), Body: respBody, URL: u}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return link, errors.Wrap(err, "failed to unmarshal response body")
+		}
+	}
+	return link, nil
+}
+
+// send builds and executes a single attempt of method/url/header/bodyBytes,
+// returning its status code, header and body.
+func (c *Client) send(ctx context.Context, method, u string, header http.Header, bodyBytes []byte) (int, http.Header, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "failed to build request")
+	}
+	for k, vs := range header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "failed to do request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, errors.Wrap(err, "failed to read response body")
+	}
+	return resp.StatusCode, resp.Header, body, nil
+}
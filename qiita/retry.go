@@ -0,0 +1,94 @@
+package qiita
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how requests are retried when the API responds with
+// 429 or a 5xx status.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry; it doubles on each
+	// subsequent one.
+	BaseDelay time.Duration
+	// Jitter is the maximum random delay added on top of the backoff.
+	Jitter time.Duration
+}
+
+// DefaultRetryPolicy is used by NewClient unless overridden via
+// WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	Jitter:      250 * time.Millisecond,
+}
+
+func (rp RetryPolicy) backoff(attempt int) time.Duration {
+	delay := rp.BaseDelay << (attempt - 1)
+	if rp.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(rp.Jitter)))
+	}
+	return delay
+}
+
+// isRetryable reports whether a response status should be retried.
+func isRetryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// retryAfter parses a Retry-After header (either a delay in seconds or an
+// HTTP-date), returning ok=false if it is absent or unparseable.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// sendWithRetry runs send, retrying on 429/5xx responses per c.retryPolicy.
+// A Retry-After header on the response takes priority over the computed
+// backoff.
+func (c *Client) sendWithRetry(ctx context.Context, method, u string, header http.Header, bodyBytes []byte) (int, http.Header, []byte, error) {
+	policy := c.retryPolicy
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	var status int
+	var respHeader http.Header
+	var body []byte
+	var err error
+
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		status, respHeader, body, err = c.send(ctx, method, u, header, bodyBytes)
+		if err != nil {
+			return status, respHeader, body, err
+		}
+		if !isRetryable(status) || attempt == policy.MaxAttempts {
+			break
+		}
+
+		delay := policy.backoff(attempt)
+		if ra, ok := retryAfter(respHeader); ok {
+			delay = ra
+		}
+		select {
+		case <-ctx.Done():
+			return status, respHeader, body, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return status, respHeader, body, nil
+}
@@ -0,0 +1,97 @@
+package qiita
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: time.Millisecond, Jitter: time.Millisecond}
+}
+
+func TestDoRequest_StatusErrorOn500(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := NewClient("token",
+		WithRetryPolicy(testRetryPolicy(3)),
+		WithBaseURL(func(subDomain, path string) string { return srv.URL + path }),
+	)
+	item, err := client.GetItem(context.Background(), "1")
+	if item != nil {
+		t.Fatalf("expected nil item, got %+v", item)
+	}
+
+	statusErr, ok := err.(*StatusError)
+	if !ok {
+		t.Fatalf("expected *StatusError, got %T: %v", err, err)
+	}
+	if statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("StatusCode = %d, want %d", statusErr.StatusCode, http.StatusInternalServerError)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+}
+
+func TestDoRequest_RetriesExactlyConfiguredAttemptsOn429(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := NewClient("token",
+		WithRetryPolicy(testRetryPolicy(4)),
+		WithBaseURL(func(subDomain, path string) string { return srv.URL + path }),
+	)
+	_, err := client.GetItem(context.Background(), "1")
+
+	statusErr, ok := err.(*StatusError)
+	if !ok {
+		t.Fatalf("expected *StatusError, got %T: %v", err, err)
+	}
+	if statusErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("StatusCode = %d, want %d", statusErr.StatusCode, http.StatusTooManyRequests)
+	}
+	if requests != 4 {
+		t.Errorf("requests = %d, want 4", requests)
+	}
+}
+
+func TestDoRequest_SucceedsTransparentlyAfterRetry(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1","title":"ok"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient("token",
+		WithRetryPolicy(testRetryPolicy(3)),
+		WithBaseURL(func(subDomain, path string) string { return srv.URL + path }),
+	)
+	item, err := client.GetItem(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetItem returned error: %v", err)
+	}
+	if item.Title != "ok" {
+		t.Errorf("Title = %q, want %q", item.Title, "ok")
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+}
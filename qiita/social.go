@@ -0,0 +1,95 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Comment represents the Qiita API's model:comment.
+type Comment struct {
+	ID        string    `json:"id"`
+	Body      string    `json:"body"`
+	User      User      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListComments fetches the comments on an item, oldest first.
+func (c *Client) ListComments(ctx context.Context, itemID string) ([]Comment, error) {
+	var comments []Comment
+	path := fmt.Sprintf("/api/v2/items/%s/comments", itemID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, nil, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// CreateComment posts a comment on an item as the authenticated user.
+func (c *Client) CreateComment(ctx context.Context, itemID, body string) (*Comment, error) {
+	var comment Comment
+	path := fmt.Sprintf("/api/v2/items/%s/comments", itemID)
+	payload := struct {
+		Body string `json:"body"`
+	}{Body: body}
+	if err := c.doRequest(ctx, http.MethodPost, path, nil, payload, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment owned by the authenticated user.
+func (c *Client) DeleteComment(ctx context.Context, commentID string) error {
+	path := fmt.Sprintf("/api/v2/comments/%s", commentID)
+	return c.doRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// Like represents the Qiita API's model:like.
+type Like struct {
+	User      User      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListLikes fetches the LGTM-likes on an item.
+func (c *Client) ListLikes(ctx context.Context, itemID string) ([]Like, error) {
+	var likes []Like
+	path := fmt.Sprintf("/api/v2/items/%s/likes", itemID)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, nil, &likes); err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
+// StockItem stocks an item as the authenticated user.
+func (c *Client) StockItem(ctx context.Context, itemID string) error {
+	path := fmt.Sprintf("/api/v2/items/%s/stock", itemID)
+	return c.doRequest(ctx, http.MethodPut, path, nil, nil, nil)
+}
+
+// UnstockItem removes an item from the authenticated user's stock.
+func (c *Client) UnstockItem(ctx context.Context, itemID string) error {
+	path := fmt.Sprintf("/api/v2/items/%s/stock", itemID)
+	return c.doRequest(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// IsStocked reports whether the authenticated user has stocked the item.
+func (c *Client) IsStocked(ctx context.Context, itemID string) (bool, error) {
+	path := fmt.Sprintf("/api/v2/items/%s/stock", itemID)
+	err := c.doRequest(ctx, http.MethodGet, path, nil, nil, nil)
+	if se, ok := err.(*StatusError); ok && se.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListStockers fetches the users who stocked an item.
+func (c *Client) ListStockers(ctx context.Context, itemID string, params ListUsersParameters) ([]User, Link, error) {
+	var users []User
+	path := fmt.Sprintf("/api/v2/items/%s/stockers", itemID)
+	link, err := c.doRequestPaged(ctx, http.MethodGet, path, params.values(), &users)
+	return users, link, err
+}
@@ -0,0 +1,130 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestComments(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/items/1/comments":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{"id":"c1","body":"nice post"}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/items/1/comments":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id":"c2","body":"thanks"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/comments/c2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	comments, err := client.ListComments(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("ListComments returned error: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Body != "nice post" {
+		t.Errorf("comments = %+v, want one comment with body %q", comments, "nice post")
+	}
+
+	created, err := client.CreateComment(context.Background(), "1", "thanks")
+	if err != nil {
+		t.Fatalf("CreateComment returned error: %v", err)
+	}
+	if created.ID != "c2" {
+		t.Errorf("created.ID = %q, want %q", created.ID, "c2")
+	}
+
+	if err := client.DeleteComment(context.Background(), created.ID); err != nil {
+		t.Fatalf("DeleteComment returned error: %v", err)
+	}
+}
+
+func TestListLikes(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/items/1/likes" {
+			t.Errorf("path = %q, want /api/v2/items/1/likes", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"user":{"id":"cumet04"}}]`)
+	})
+
+	likes, err := client.ListLikes(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("ListLikes returned error: %v", err)
+	}
+	if len(likes) != 1 || likes[0].User.ID != "cumet04" {
+		t.Errorf("likes = %+v, want one like from cumet04", likes)
+	}
+}
+
+func TestStock(t *testing.T) {
+	stocked := false
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/items/1/stock" {
+			t.Errorf("path = %q, want /api/v2/items/1/stock", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodPut:
+			stocked = true
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			stocked = false
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			if stocked {
+				w.WriteHeader(http.StatusNoContent)
+			} else {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	if ok, err := client.IsStocked(ctx, "1"); err != nil || ok {
+		t.Fatalf("IsStocked = %v, %v, want false, nil", ok, err)
+	}
+
+	if err := client.StockItem(ctx, "1"); err != nil {
+		t.Fatalf("StockItem returned error: %v", err)
+	}
+	if ok, err := client.IsStocked(ctx, "1"); err != nil || !ok {
+		t.Fatalf("IsStocked = %v, %v, want true, nil", ok, err)
+	}
+
+	if err := client.UnstockItem(ctx, "1"); err != nil {
+		t.Fatalf("UnstockItem returned error: %v", err)
+	}
+	if ok, err := client.IsStocked(ctx, "1"); err != nil || ok {
+		t.Fatalf("IsStocked = %v, %v, want false, nil", ok, err)
+	}
+}
+
+func TestListStockers_ParsesLinkHeader(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/items/1/stockers" {
+			t.Errorf("path = %q, want /api/v2/items/1/stockers", r.URL.Path)
+		}
+		w.Header().Set("Link", `<https://qiita.com/api/v2/items/1/stockers?page=2>; rel="next"`)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"cumet04"}]`)
+	})
+
+	users, link, err := client.ListStockers(context.Background(), "1", ListUsersParameters{})
+	if err != nil {
+		t.Fatalf("ListStockers returned error: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != "cumet04" {
+		t.Errorf("users = %+v, want [{ID: cumet04}]", users)
+	}
+	if link.Next == "" {
+		t.Error("link.Next is empty, want a next page URL")
+	}
+}
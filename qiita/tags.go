@@ -0,0 +1,48 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Tag represents the Qiita API's model:tag.
+type Tag struct {
+	ID             string `json:"id"`
+	IconURL        string `json:"icon_url"`
+	ItemsCount     int    `json:"items_count"`
+	FollowersCount int    `json:"followers_count"`
+}
+
+// ListTagsParameters configures ListTags.
+type ListTagsParameters struct {
+	PageParameters
+	// Sort is "count" (default) or "name".
+	Sort string
+}
+
+func (p ListTagsParameters) values() url.Values {
+	v := p.PageParameters.values()
+	if p.Sort != "" {
+		v.Set("sort", p.Sort)
+	}
+	return v
+}
+
+// ListTags fetches tags, most followed first by default.
+func (c *Client) ListTags(ctx context.Context, params ListTagsParameters) ([]Tag, Link, error) {
+	var tags []Tag
+	link, err := c.doRequestPaged(ctx, http.MethodGet, "/api/v2/tags", params.values(), &tags)
+	return tags, link, err
+}
+
+// GetTag fetches a single tag by ID.
+func (c *Client) GetTag(ctx context.Context, id string) (*Tag, error) {
+	var tag Tag
+	path := fmt.Sprintf("/api/v2/tags/%s", id)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, nil, &tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
@@ -0,0 +1,51 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListTags_ParsesLinkHeader(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/tags" {
+			t.Errorf("path = %q, want /api/v2/tags", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("sort"); got != "count" {
+			t.Errorf("sort query = %q, want %q", got, "count")
+		}
+		w.Header().Set("Link", `<https://qiita.com/api/v2/tags?page=2>; rel="next"`)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"go","items_count":100}]`)
+	})
+
+	tags, link, err := client.ListTags(context.Background(), ListTagsParameters{Sort: "count"})
+	if err != nil {
+		t.Fatalf("ListTags returned error: %v", err)
+	}
+	if len(tags) != 1 || tags[0].ID != "go" || tags[0].ItemsCount != 100 {
+		t.Errorf("tags = %+v, want [{ID: go, ItemsCount: 100}]", tags)
+	}
+	if link.Next == "" {
+		t.Error("link.Next is empty, want a next page URL")
+	}
+}
+
+func TestGetTag(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/tags/go" {
+			t.Errorf("path = %q, want /api/v2/tags/go", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"go","items_count":100}`)
+	})
+
+	tag, err := client.GetTag(context.Background(), "go")
+	if err != nil {
+		t.Fatalf("GetTag returned error: %v", err)
+	}
+	if tag.ID != "go" {
+		t.Errorf("ID = %q, want %q", tag.ID, "go")
+	}
+}
@@ -0,0 +1,45 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// User represents the Qiita API's model:user.
+type User struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	ProfileImageURL string `json:"profile_image_url"`
+	ItemsCount      int    `json:"items_count"`
+	FollowersCount  int    `json:"followers_count"`
+	FolloweesCount  int    `json:"followees_count"`
+}
+
+// ListUsersParameters configures ListUsers.
+type ListUsersParameters struct {
+	PageParameters
+}
+
+func (p ListUsersParameters) values() url.Values {
+	return p.PageParameters.values()
+}
+
+// ListUsers fetches users, in no particular guaranteed order.
+func (c *Client) ListUsers(ctx context.Context, params ListUsersParameters) ([]User, Link, error) {
+	var users []User
+	link, err := c.doRequestPaged(ctx, http.MethodGet, "/api/v2/users", params.values(), &users)
+	return users, link, err
+}
+
+// GetUser fetches a single user by ID.
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	var user User
+	path := fmt.Sprintf("/api/v2/users/%s", id)
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
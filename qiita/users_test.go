@@ -0,0 +1,48 @@
+package qiita
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListUsers_ParsesLinkHeader(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/users" {
+			t.Errorf("path = %q, want /api/v2/users", r.URL.Path)
+		}
+		w.Header().Set("Link", `<https://qiita.com/api/v2/users?page=2>; rel="next"`)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"cumet04"}]`)
+	})
+
+	users, link, err := client.ListUsers(context.Background(), ListUsersParameters{})
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if len(users) != 1 || users[0].ID != "cumet04" {
+		t.Errorf("users = %+v, want [{ID: cumet04}]", users)
+	}
+	if link.Next == "" {
+		t.Error("link.Next is empty, want a next page URL")
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/users/cumet04" {
+			t.Errorf("path = %q, want /api/v2/users/cumet04", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"cumet04"}`)
+	})
+
+	user, err := client.GetUser(context.Background(), "cumet04")
+	if err != nil {
+		t.Fatalf("GetUser returned error: %v", err)
+	}
+	if user.ID != "cumet04" {
+		t.Errorf("ID = %q, want %q", user.ID, "cumet04")
+	}
+}
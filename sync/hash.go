@@ -0,0 +1,26 @@
+package sync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/cumet04/sbox_qiita_api/markdown"
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+// contentHash hashes the markdown encoding of an item's editable fields, so
+// it changes whenever the title/body/tags/private a user can edit change,
+// regardless of ID/timestamps.
+func contentHash(item *qiita.Item) (string, error) {
+	encoded, err := markdown.Encode(&qiita.Item{
+		Title:   item.Title,
+		Body:    item.Body,
+		Tags:    item.Tags,
+		Private: item.Private,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// stateFile is the sidecar that tracks the last-known remote state of each
+// synced item, relative to the tracked directory.
+const stateFile = ".qiita/state.json"
+
+// StateItem is what we knew about an item as of the last pull/push.
+type StateItem struct {
+	ID              string    `json:"id"`
+	Path            string    `json:"path"`
+	RemoteUpdatedAt time.Time `json:"remote_updated_at"`
+	LocalHash       string    `json:"local_hash"`
+}
+
+// State is the sidecar document, keyed by item ID.
+type State struct {
+	Items map[string]StateItem `json:"items"`
+}
+
+// LoadState reads the sidecar state for dir, returning an empty State if it
+// does not exist yet.
+func LoadState(dir string) (*State, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, stateFile))
+	if os.IsNotExist(err) {
+		return &State{Items: map[string]StateItem{}}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read state file")
+	}
+
+	var s State
+	if err := json.Unmarshal(buf, &s); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal state file")
+	}
+	if s.Items == nil {
+		s.Items = map[string]StateItem{}
+	}
+	return &s, nil
+}
+
+// Save writes the sidecar state for dir, creating the .qiita directory if
+// necessary.
+func (s *State) Save(dir string) error {
+	path := filepath.Join(dir, stateFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, "failed to create state directory")
+	}
+
+	buf, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal state file")
+	}
+	if err := ioutil.WriteFile(path, buf, 0644); err != nil {
+		return errors.Wrap(err, "failed to write state file")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// Package sync manages a local directory of Markdown files as a mirror of
+// the authenticated user's Qiita items, tracking the last-known remote
+// state in a .qiita/state.json sidecar so Status can tell which posts are
+// new/modified/deleted without any API calls.
+package sync
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/cumet04/sbox_qiita_api/markdown"
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+// Pull fetches every item belonging to the authenticated user and writes it
+// to dir, overwriting whatever is already there and refreshing the sidecar
+// state.
+func Pull(ctx context.Context, client *qiita.Client, dir string) error {
+	state, err := LoadState(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "failed to create posts directory")
+	}
+
+	params := qiita.ListItemsParameters{PageParameters: qiita.PageParameters{Page: 1, PerPage: 100}}
+	for {
+		items, link, err := client.ListAuthenticatedUserItems(ctx, params)
+		if err != nil {
+			return errors.Wrap(err, "failed to list items")
+		}
+
+		for _, item := range items {
+			if err := writeItemAt(state, itemPath(dir, &item), &item); err != nil {
+				return err
+			}
+		}
+
+		if link.Next == "" {
+			break
+		}
+		params.Page++
+	}
+
+	return state.Save(dir)
+}
+
+// writeItemAt encodes item to path and records it in state, keyed by
+// item.ID. Push uses this to write back to the file the caller handed it,
+// rather than a freshly-derived <id>-<slug>.md, so a second push against
+// the same file sees the id it just got and updates instead of
+// re-creating.
+func writeItemAt(state *State, path string, item *qiita.Item) error {
+	encoded, err := markdown.Encode(item)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode item %s", item.ID)
+	}
+
+	if err := ioutil.WriteFile(path, encoded, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+
+	hash, err := contentHash(item)
+	if err != nil {
+		return err
+	}
+	state.Items[item.ID] = StateItem{
+		ID:              item.ID,
+		Path:            path,
+		RemoteUpdatedAt: item.UpdatedAt,
+		LocalHash:       hash,
+	}
+	return nil
+}
+
+// Push reads the item at path and creates or updates it on Qiita, depending
+// on whether it already has an id in its front matter, then writes the
+// result (with its id, if newly assigned) back to path so a later push of
+// the same file updates instead of re-creating. It returns the pushed item.
+// Push does not fetch the remote item before updating it, so it always
+// costs a single API call; it also means Push cannot detect that the
+// remote item changed since the last pull, so it will overwrite that
+// change. Status does not detect remote changes either, since it only
+// compares local files against the sidecar state.
+func Push(ctx context.Context, client *qiita.Client, dir, path string) (*qiita.Item, error) {
+	state, err := LoadState(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read local file")
+	}
+	local, err := markdown.Decode(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode local file")
+	}
+
+	if local.ID == "" {
+		created, err := client.CreateItem(ctx, qiita.CreateItemParameters{
+			Title:   local.Title,
+			Body:    local.Body,
+			Tags:    local.Tags,
+			Private: local.Private,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create item")
+		}
+		if err := writeItemAt(state, path, created); err != nil {
+			return nil, err
+		}
+		return created, state.Save(dir)
+	}
+
+	updated, err := client.UpdateItem(ctx, local.ID, qiita.UpdateItemParameters{
+		Title:   local.Title,
+		Body:    local.Body,
+		Tags:    local.Tags,
+		Private: local.Private,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update item")
+	}
+	if err := writeItemAt(state, path, updated); err != nil {
+		return nil, err
+	}
+	return updated, state.Save(dir)
+}
+
+// Change describes how a local file has drifted from the last known sync
+// state, mirroring `git status`'s new/modified/deleted.
+type Change struct {
+	Status string // "new", "modified", or "deleted"
+	Path   string
+}
+
+// Status compares the Markdown files in dir against the sidecar state and
+// reports new, modified and deleted posts.
+func Status(dir string) ([]Change, error) {
+	state, err := LoadState(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to list posts directory")
+	}
+
+	seen := map[string]bool{}
+	var changes []Change
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", path)
+		}
+		item, err := markdown.Decode(buf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", path)
+		}
+
+		known, tracked := state.Items[item.ID]
+		if !tracked {
+			changes = append(changes, Change{Status: "new", Path: path})
+			continue
+		}
+		seen[item.ID] = true
+
+		hash, err := contentHash(item)
+		if err != nil {
+			return nil, err
+		}
+		if hash != known.LocalHash {
+			changes = append(changes, Change{Status: "modified", Path: path})
+		}
+	}
+
+	for id, known := range state.Items {
+		if !seen[id] {
+			changes = append(changes, Change{Status: "deleted", Path: known.Path})
+		}
+	}
+	return changes, nil
+}
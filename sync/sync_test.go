@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+// fakeQiita is a minimal in-memory stand-in for the parts of the Qiita API
+// Push talks to, so tests can assert how many times each endpoint was hit.
+type fakeQiita struct {
+	creates int
+	updates int
+	items   map[string]*qiita.Item
+	nextID  int
+}
+
+func newFakeQiita() *fakeQiita {
+	return &fakeQiita{items: map[string]*qiita.Item{}}
+}
+
+func (f *fakeQiita) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/items", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.NotFound(w, r)
+			return
+		}
+		var params qiita.CreateItemParameters
+		json.NewDecoder(r.Body).Decode(&params)
+
+		f.creates++
+		f.nextID++
+		id := fmt.Sprintf("a%d", f.nextID)
+		item := &qiita.Item{ID: id, Title: params.Title, Body: params.Body, Tags: params.Tags, Private: params.Private}
+		f.items[id] = item
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(item)
+	})
+	mux.HandleFunc("/api/v2/items/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/items/")
+		item, ok := f.items[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(item)
+		case http.MethodPatch:
+			var params qiita.UpdateItemParameters
+			json.NewDecoder(r.Body).Decode(&params)
+			f.updates++
+			item.Title, item.Body, item.Tags, item.Private = params.Title, params.Body, params.Tags, params.Private
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(item)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	return mux
+}
+
+func TestPush_SecondPushUpdatesInsteadOfRecreating(t *testing.T) {
+	fake := newFakeQiita()
+	srv := httptest.NewServer(fake.handler())
+	defer srv.Close()
+
+	client := qiita.NewClient("token", qiita.WithBaseURL(func(subDomain, path string) string { return srv.URL + path }))
+
+	dir := t.TempDir()
+	draft := filepath.Join(dir, "draft.md")
+	if err := os.WriteFile(draft, []byte("---\ntitle: hello\nprivate: true\n---\nbody\n"), 0644); err != nil {
+		t.Fatalf("failed to write draft: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := Push(ctx, client, dir, draft); err != nil {
+		t.Fatalf("first Push returned error: %v", err)
+	}
+	if fake.creates != 1 {
+		t.Fatalf("after first push, creates = %d, want 1", fake.creates)
+	}
+
+	if _, err := Push(ctx, client, dir, draft); err != nil {
+		t.Fatalf("second Push returned error: %v", err)
+	}
+	if fake.creates != 1 {
+		t.Errorf("after second push, creates = %d, want still 1 (should update, not recreate)", fake.creates)
+	}
+	if fake.updates != 1 {
+		t.Errorf("after second push, updates = %d, want 1", fake.updates)
+	}
+
+	buf, err := os.ReadFile(draft)
+	if err != nil {
+		t.Fatalf("failed to re-read draft: %v", err)
+	}
+	if !strings.Contains(string(buf), "id: a1") {
+		t.Errorf("expected draft file to be rewritten with its assigned id, got:\n%s", buf)
+	}
+}
@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cumet04/sbox_qiita_api/qiita"
+)
+
+var nonSlugRe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify normalizes a title into the slug used in an item's file name.
+func slugify(title string) string {
+	slug := strings.Trim(nonSlugRe.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if slug == "" {
+		slug = "untitled"
+	}
+	return slug
+}
+
+// itemPath returns the file an item is stored at under dir, named
+// <id>-<slug>.md after the item's ID and slugified title.
+func itemPath(dir string, item *qiita.Item) string {
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.md", item.ID, slugify(item.Title)))
+}